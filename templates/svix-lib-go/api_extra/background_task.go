@@ -0,0 +1,183 @@
+// BackgroundTask exposes Svix's background-task endpoints, used to poll the
+// status of expensive asynchronous operations (e.g. usage-stat recomputation,
+// mass expunge) that return a task id instead of completing inline.
+type BackgroundTask struct {
+	api *openapi.APIClient
+}
+
+func newBackgroundTask(api *openapi.APIClient) *BackgroundTask {
+	return &BackgroundTask{api}
+}
+
+// Get fetches the current status of a background task by id.
+func (backgroundTask *BackgroundTask) Get(ctx context.Context, taskId string) (*openapi.BackgroundTaskOut, error) {
+	out, res, err := backgroundTask.api.BackgroundTaskApi.V1BackgroundTaskGet(ctx, taskId).Execute()
+	if err != nil {
+		return nil, wrapError(err, res)
+	}
+	return out, nil
+}
+
+// BackgroundTaskListOptions holds the optional parameters accepted by
+// `BackgroundTask.List`.
+type BackgroundTaskListOptions struct {
+	Status   *openapi.BackgroundTaskStatus
+	Task     *openapi.BackgroundTaskType
+	Iterator *string
+	Limit    *int32
+}
+
+// List lists background tasks, optionally filtered by status and/or task
+// type.
+func (backgroundTask *BackgroundTask) List(
+	ctx context.Context,
+	options *BackgroundTaskListOptions,
+) (*openapi.ListResponseBackgroundTaskOut, error) {
+	req := backgroundTask.api.BackgroundTaskApi.V1BackgroundTaskList(ctx)
+	if options != nil {
+		if options.Status != nil {
+			req = req.Status(*options.Status)
+		}
+		if options.Task != nil {
+			req = req.Task(*options.Task)
+		}
+		if options.Iterator != nil {
+			req = req.Iterator(*options.Iterator)
+		}
+		if options.Limit != nil {
+			req = req.Limit(*options.Limit)
+		}
+	}
+
+	out, res, err := req.Execute()
+	if err != nil {
+		return nil, wrapError(err, res)
+	}
+	return out, nil
+}
+
+// BackgroundTaskWaitOptions configures how `BackgroundTask.Wait` polls for a
+// task's completion.
+type BackgroundTaskWaitOptions struct {
+	// PollInterval is the initial delay between polls of the task's status.
+	// Defaults to 500ms if unset.
+	PollInterval time.Duration
+	// MaxPollInterval caps the exponential backoff applied to PollInterval
+	// between polls. Defaults to 5s if unset.
+	MaxPollInterval time.Duration
+}
+
+// ErrBackgroundTaskFailed is returned by `Wait` when the task transitions to
+// the `failed` status rather than `finished`.
+type ErrBackgroundTaskFailed struct {
+	Task *openapi.BackgroundTaskOut
+}
+
+func (e *ErrBackgroundTaskFailed) Error() string {
+	return fmt.Sprintf("background task %s failed", e.Task.Id)
+}
+
+// Wait polls the status of a background task until it transitions to
+// `finished` or `failed`, backing off exponentially between polls starting at
+// `opts.PollInterval` (default 500ms) up to `opts.MaxPollInterval` (default
+// 5s). It returns the final BackgroundTaskOut on success, or an
+// `*ErrBackgroundTaskFailed` wrapping it if the task failed. `opts` may be
+// nil to use the defaults. Returns early with `ctx.Err()` if `ctx` is
+// cancelled while waiting between polls.
+func (backgroundTask *BackgroundTask) Wait(
+	ctx context.Context,
+	taskId string,
+	opts *BackgroundTaskWaitOptions,
+) (*openapi.BackgroundTaskOut, error) {
+	pollInterval := 500 * time.Millisecond
+	maxPollInterval := 5 * time.Second
+	if opts != nil {
+		if opts.PollInterval > 0 {
+			pollInterval = opts.PollInterval
+		}
+		if opts.MaxPollInterval > 0 {
+			maxPollInterval = opts.MaxPollInterval
+		}
+	}
+
+	for {
+		task, err := backgroundTask.Get(ctx, taskId)
+		if err != nil {
+			return nil, err
+		}
+
+		switch task.Status {
+		case openapi.FINISHED_BackgroundTaskStatus:
+			return task, nil
+		case openapi.FAILED_BackgroundTaskStatus:
+			return task, &ErrBackgroundTaskFailed{Task: task}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+
+		pollInterval *= 2
+		if pollInterval > maxPollInterval {
+			pollInterval = maxPollInterval
+		}
+	}
+}
+
+// ApplicationUsageStatsOptions configures `Application.UsageStats`.
+type ApplicationUsageStatsOptions struct {
+	// Await, if true, blocks until the background task completes (using
+	// Wait's default polling options) before returning.
+	Await bool
+}
+
+// UsageStats kicks off a recomputation of usage statistics for the given
+// application ids, returning the resulting background task. If
+// `options.Await` is set, it blocks until the task finishes or fails before
+// returning, via `BackgroundTask.Wait`.
+func (application *Application) UsageStats(
+	ctx context.Context,
+	appIds []string,
+	options *ApplicationUsageStatsOptions,
+) (*openapi.BackgroundTaskOut, error) {
+	in := openapi.NewAppUsageStatsIn(appIds)
+	out, res, err := application.api.ApplicationApi.V1ApplicationUsageStats(ctx).AppUsageStatsIn(*in).Execute()
+	if err != nil {
+		return nil, wrapError(err, res)
+	}
+
+	if options != nil && options.Await {
+		return newBackgroundTask(application.api).Wait(ctx, out.Id, nil)
+	}
+	return out, nil
+}
+
+// ExpungeAllContentsOptions configures `Message.ExpungeAllContents`.
+type ExpungeAllContentsOptions struct {
+	// Await, if true, blocks until the background task completes (using
+	// Wait's default polling options) before returning.
+	Await bool
+}
+
+// ExpungeAllContents kicks off an irreversible deletion of the payload and
+// response bodies of every message and attempt belonging to `appId`,
+// returning the resulting background task. If `options.Await` is set, it
+// blocks until the task finishes or fails before returning, via
+// `BackgroundTask.Wait`.
+func (message *Message) ExpungeAllContents(
+	ctx context.Context,
+	appId string,
+	options *ExpungeAllContentsOptions,
+) (*openapi.BackgroundTaskOut, error) {
+	out, res, err := message.api.MessageApi.V1MessageExpungeAllContents(ctx, appId).Execute()
+	if err != nil {
+		return nil, wrapError(err, res)
+	}
+
+	if options != nil && options.Await {
+		return newBackgroundTask(message.api).Wait(ctx, out.Id, nil)
+	}
+	return out, nil
+}
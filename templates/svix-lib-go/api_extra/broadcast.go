@@ -0,0 +1,135 @@
+// Broadcast sends the same event to every application in an environment.
+type Broadcast struct {
+	api *openapi.APIClient
+}
+
+func newBroadcast(api *openapi.APIClient) *Broadcast {
+	return &Broadcast{api}
+}
+
+// Instantiates a new BroadcastMessageIn object with a raw string payload.
+//
+// See `NewMessageInRaw` for details on raw payloads; this behaves
+// identically, just against the broadcast model.
+func NewBroadcastMessageInRaw(
+	eventType string,
+	payload string,
+	contentType openapi.NullableString,
+) *BroadcastMessageIn {
+	return NewBroadcastMessageInRawWithHeaders(eventType, payload, contentType, nil)
+}
+
+// Instantiates a new BroadcastMessageIn object with a raw string payload and a
+// set of custom headers to send alongside the webhook.
+//
+// See `NewMessageInRawWithHeaders` for details; this behaves identically,
+// just against the broadcast model.
+func NewBroadcastMessageInRawWithHeaders(
+	eventType string,
+	payload string,
+	contentType openapi.NullableString,
+	headers map[string]string,
+) *BroadcastMessageIn {
+	msgIn := openapi.NewBroadcastMessageIn(eventType, make(map[string]interface{}))
+
+	transformationsParams := map[string]interface{}{
+		"rawPayload": payload,
+	}
+
+	mergedHeaders := make(map[string]string, len(headers))
+	for k, v := range headers {
+		mergedHeaders[k] = v
+	}
+	if contentType.IsSet() {
+		mergedHeaders["content-type"] = *contentType.Get()
+	}
+	if len(mergedHeaders) > 0 {
+		transformationsParams["headers"] = mergedHeaders
+	}
+	msgIn.SetTransformationsParams(transformationsParams)
+
+	return msgIn
+}
+
+// Instantiates a new BroadcastMessageIn object with a raw binary payload.
+//
+// See `NewMessageInRawBytes` for details; this behaves identically, just
+// against the broadcast model.
+func NewBroadcastMessageInRawBytes(
+	eventType string,
+	payload []byte,
+	contentType openapi.NullableString,
+	headers map[string]string,
+) *BroadcastMessageIn {
+	msgIn := NewBroadcastMessageInRawWithHeaders(eventType, base64.StdEncoding.EncodeToString(payload), contentType, headers)
+
+	transformationsParams := msgIn.GetTransformationsParams()
+	transformationsParams["rawPayloadEncoding"] = "base64"
+	msgIn.SetTransformationsParams(transformationsParams)
+
+	return msgIn
+}
+
+// BroadcastCreateOptions holds the optional parameters accepted by
+// `Broadcast.Create`.
+type BroadcastCreateOptions struct {
+	IdempotencyKey *string
+}
+
+// Create sends broadcastIn to every application in the environment.
+func (broadcast *Broadcast) Create(
+	ctx context.Context,
+	broadcastIn *BroadcastMessageIn,
+	options *BroadcastCreateOptions,
+) (*openapi.BroadcastMessageOut, error) {
+	req := broadcast.api.MessageApi.V1MessageBroadcastCreate(ctx).BroadcastMessageIn(*broadcastIn)
+	if options != nil && options.IdempotencyKey != nil {
+		req = req.IdempotencyKey(*options.IdempotencyKey)
+	}
+
+	out, res, err := req.Execute()
+	if err != nil {
+		return nil, wrapError(err, res)
+	}
+	return out, nil
+}
+
+// Get fetches a previously sent broadcast message by id.
+func (broadcast *Broadcast) Get(ctx context.Context, msgId string) (*openapi.BroadcastMessageOut, error) {
+	out, res, err := broadcast.api.MessageApi.V1MessageBroadcastGet(ctx, msgId).Execute()
+	if err != nil {
+		return nil, wrapError(err, res)
+	}
+	return out, nil
+}
+
+// BroadcastListAttemptedDestinationsOptions holds the optional parameters
+// accepted by `Broadcast.ListAttemptedDestinations`.
+type BroadcastListAttemptedDestinationsOptions struct {
+	Iterator *string
+	Limit    *int32
+}
+
+// ListAttemptedDestinations lists the endpoints a broadcast message was
+// attempted against.
+func (broadcast *Broadcast) ListAttemptedDestinations(
+	ctx context.Context,
+	msgId string,
+	options *BroadcastListAttemptedDestinationsOptions,
+) (*openapi.ListResponseEndpointMessageOut, error) {
+	req := broadcast.api.MessageApi.V1MessageBroadcastListAttemptedDestinations(ctx, msgId)
+	if options != nil {
+		if options.Iterator != nil {
+			req = req.Iterator(*options.Iterator)
+		}
+		if options.Limit != nil {
+			req = req.Limit(*options.Limit)
+		}
+	}
+
+	out, res, err := req.Execute()
+	if err != nil {
+		return nil, wrapError(err, res)
+	}
+	return out, nil
+}
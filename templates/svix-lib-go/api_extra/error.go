@@ -0,0 +1,13 @@
+// wrapError wraps an error returned by the generated OpenAPI client, adding
+// the HTTP status line of `res` (when there is one) to the error message. The
+// original error stays reachable via `errors.Is`/`errors.As`; the response
+// itself is not attached, so its body cannot be read from the returned error.
+func wrapError(err error, res *http.Response) error {
+	if err == nil {
+		return nil
+	}
+	if res == nil {
+		return err
+	}
+	return fmt.Errorf("%w (status %s)", err, res.Status)
+}
@@ -0,0 +1,125 @@
+// Iter is a generic, auto-paginating iterator over a List* endpoint's
+// results. Construct one via a resource's `Iter*` method (e.g.
+// `messageAttempt.IterByMsg`) rather than directly. This is the preferred way
+// to walk a full result set: it refills pages internally instead of
+// requiring callers to thread `ListResponse*.Iterator` back into
+// `*ListOptions` by hand.
+//
+// `ctx` is not bound at construction time (the per-resource constructors
+// take none); it's threaded through `Next`/`All` instead, same as every other
+// method in this package. This lets one `Iter` outlive the context used for
+// any single page fetch (e.g. a fresh per-page timeout) instead of pinning
+// the whole walk to one deadline.
+type Iter[T any] struct {
+	fetch    func(ctx context.Context, iterator *string) ([]T, *string, bool, error)
+	iterator *string
+	done     bool
+	buf      []T
+}
+
+func newIter[T any](seed *string, fetch func(ctx context.Context, iterator *string) ([]T, *string, bool, error)) *Iter[T] {
+	return &Iter[T]{fetch: fetch, iterator: seed}
+}
+
+// Next returns the next item in the iteration, transparently fetching
+// another page from the API once the current page is exhausted. The second
+// return value is false once the iteration is complete, at which point the
+// returned item is nil.
+func (it *Iter[T]) Next(ctx context.Context) (*T, bool, error) {
+	for len(it.buf) == 0 {
+		if it.done {
+			return nil, false, nil
+		}
+
+		page, nextIterator, done, err := it.fetch(ctx, it.iterator)
+		if err != nil {
+			return nil, false, err
+		}
+
+		it.buf = page
+		it.iterator = nextIterator
+		it.done = done
+	}
+
+	item := it.buf[0]
+	it.buf = it.buf[1:]
+	return &item, true, nil
+}
+
+// All drains the iterator, collecting every remaining item into a single
+// slice. For very large result sets, prefer Next so the whole set doesn't
+// need to be held in memory at once.
+func (it *Iter[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for {
+		item, ok, err := it.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return all, nil
+		}
+		all = append(all, *item)
+	}
+}
+
+// IterByMsg returns an auto-paginating iterator over the delivery attempts
+// for a given message, refilling pages internally via ListByMsg.
+func (messageAttempt *MessageAttempt) IterByMsg(
+	appId string,
+	msgId string,
+	options *MessageAttemptListOptions,
+) *Iter[MessageAttemptOut] {
+	var seed *string
+	if options != nil {
+		seed = options.Iterator
+	}
+
+	return newIter(seed, func(ctx context.Context, iterator *string) ([]MessageAttemptOut, *string, bool, error) {
+		opts := MessageAttemptListOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.Iterator = iterator
+
+		page, err := messageAttempt.ListByMsg(ctx, appId, msgId, &opts)
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		return page.Data, page.Iterator, page.Done, nil
+	})
+}
+
+// IterByEndpoint returns an auto-paginating iterator over the delivery
+// attempts against a given endpoint, refilling pages internally via
+// ListByEndpoint.
+func (messageAttempt *MessageAttempt) IterByEndpoint(
+	appId string,
+	endpointId string,
+	options *MessageAttemptListOptions,
+) *Iter[MessageAttemptOut] {
+	var seed *string
+	if options != nil {
+		seed = options.Iterator
+	}
+
+	return newIter(seed, func(ctx context.Context, iterator *string) ([]MessageAttemptOut, *string, bool, error) {
+		opts := MessageAttemptListOptions{}
+		if options != nil {
+			opts = *options
+		}
+		opts.Iterator = iterator
+
+		page, err := messageAttempt.ListByEndpoint(ctx, appId, endpointId, &opts)
+		if err != nil {
+			return nil, nil, false, err
+		}
+
+		return page.Data, page.Iterator, page.Done, nil
+	})
+}
+
+// Only MessageAttempt has Iter* constructors (IterByMsg and IterByEndpoint).
+// The List* methods of other resources (Application, Endpoint, ...) have no
+// iterator counterpart in this file.
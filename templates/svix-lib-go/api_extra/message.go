@@ -14,18 +14,64 @@ func NewMessageInRaw(
 	eventType string,
 	payload string,
 	contentType openapi.NullableString,
+) *MessageIn {
+	return NewMessageInRawWithHeaders(eventType, payload, contentType, nil)
+}
+
+// Instantiates a new MessageIn object with a raw string payload and a set of
+// custom headers to send alongside the webhook.
+//
+// This behaves exactly like `NewMessageInRaw`, except `headers` is merged into
+// `transformationsParams["headers"]` in addition to `content-type`. This is
+// useful for webhooks that need extra headers such as `Authorization` or
+// `X-Custom-Id`. If `headers` also has a `content-type` key, the
+// `contentType` parameter overwrites it. Keys are matched exactly, so a key
+// spelled differently (e.g. `Content-Type`) is kept alongside it.
+func NewMessageInRawWithHeaders(
+	eventType string,
+	payload string,
+	contentType openapi.NullableString,
+	headers map[string]string,
 ) *MessageIn {
 	msgIn := openapi.NewMessageIn(eventType, make(map[string]interface{}))
 
 	transformationsParams := map[string]interface{}{
 		"rawPayload": payload,
 	}
+
+	mergedHeaders := make(map[string]string, len(headers))
+	for k, v := range headers {
+		mergedHeaders[k] = v
+	}
 	if contentType.IsSet() {
-		transformationsParams["headers"] = map[string]string{
-			"content-type": *contentType.Get(),
-		}
+		mergedHeaders["content-type"] = *contentType.Get()
+	}
+	if len(mergedHeaders) > 0 {
+		transformationsParams["headers"] = mergedHeaders
 	}
 	msgIn.SetTransformationsParams(transformationsParams)
 
 	return msgIn
 }
+
+// Instantiates a new MessageIn object with a raw binary payload.
+//
+// This behaves exactly like `NewMessageInRawWithHeaders`, except `payload` is
+// base64-encoded into `rawPayload` and a `rawPayloadEncoding: "base64"` hint
+// is added to `transformationsParams` so Svix decodes it back to the original
+// bytes before delivering the webhook. Use this for binary payloads (e.g.
+// protobuf) that would otherwise be mangled by passing through a Go `string`.
+func NewMessageInRawBytes(
+	eventType string,
+	payload []byte,
+	contentType openapi.NullableString,
+	headers map[string]string,
+) *MessageIn {
+	msgIn := NewMessageInRawWithHeaders(eventType, base64.StdEncoding.EncodeToString(payload), contentType, headers)
+
+	transformationsParams := msgIn.GetTransformationsParams()
+	transformationsParams["rawPayloadEncoding"] = "base64"
+	msgIn.SetTransformationsParams(transformationsParams)
+
+	return msgIn
+}
@@ -0,0 +1,18 @@
+// StatusCodeClass is a coarse grouping of the HTTP status codes returned by
+// an endpoint on a given delivery attempt (e.g. "any 5xx"), meant to back a
+// `MessageAttemptListOptions.StatusCodeClass` filter field without requiring
+// callers to import `internal/openapi` directly.
+//
+// TODO: the `StatusCodeClass` field itself, and reading it in
+// `ListByMsg`/`ListByEndpoint`, still need to land in the generated
+// model/client code this alias isn't part of.
+type StatusCodeClass = openapi.StatusCodeClass
+
+const (
+	StatusCodeClassCodeNone StatusCodeClass = openapi.CODENONE_StatusCodeClass
+	StatusCodeClassCode1xx  StatusCodeClass = openapi.CODE1XX_StatusCodeClass
+	StatusCodeClassCode2xx  StatusCodeClass = openapi.CODE2XX_StatusCodeClass
+	StatusCodeClassCode3xx  StatusCodeClass = openapi.CODE3XX_StatusCodeClass
+	StatusCodeClassCode4xx  StatusCodeClass = openapi.CODE4XX_StatusCodeClass
+	StatusCodeClassCode5xx  StatusCodeClass = openapi.CODE5XX_StatusCodeClass
+)
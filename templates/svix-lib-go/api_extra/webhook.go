@@ -0,0 +1,131 @@
+const webhookSecretPrefix = "whsec_"
+
+// DefaultWebhookTolerance is the maximum allowed difference between a
+// webhook's `svix-timestamp` header and the current time before `Verify`
+// rejects it as expired or from the future.
+const DefaultWebhookTolerance = 5 * time.Minute
+
+// ErrInvalidWebhookSignature is returned by `Verify` when none of the
+// signatures in the `svix-signature` header match the expected HMAC, or when
+// any of the required headers is missing. A `svix-timestamp` that is not an
+// integer makes `Verify` return a separate "invalid webhook timestamp" error.
+var ErrInvalidWebhookSignature = errors.New("webhook has no valid signature")
+
+// ErrWebhookTimestampOutOfTolerance is returned by `Verify` when the
+// `svix-timestamp` header is further from the current time than the
+// configured tolerance allows.
+var ErrWebhookTimestampOutOfTolerance = errors.New("webhook timestamp is out of tolerance")
+
+// Webhook verifies the authenticity of incoming Svix webhooks using the
+// endpoint's signing secret.
+type Webhook struct {
+	secret []byte
+
+	// Tolerance is the maximum allowed skew between the `svix-timestamp`
+	// header and the current time. Defaults to DefaultWebhookTolerance.
+	// Exposed as a field so tests can relax or tighten it.
+	Tolerance time.Duration
+}
+
+// NewWebhook creates a Webhook verifier from a signing secret, as found on
+// the endpoint's settings page (prefixed with `whsec_`).
+func NewWebhook(secret string) (*Webhook, error) {
+	secret = strings.TrimPrefix(secret, webhookSecretPrefix)
+
+	decoded, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook secret: %w", err)
+	}
+
+	return &Webhook{
+		secret:    decoded,
+		Tolerance: DefaultWebhookTolerance,
+	}, nil
+}
+
+// Verify checks that `payload` was sent by Svix, by recomputing the HMAC
+// signature over `svix-id.svix-timestamp.payload` and comparing it against
+// the `svix-signature` header (falling back to the `webhook-*` header
+// aliases used by some proxies). It also rejects requests whose
+// `svix-timestamp` is further from the current time than `w.Tolerance`
+// allows. Returns nil if, and only if, the payload is authentic.
+func (w *Webhook) Verify(payload []byte, headers http.Header) error {
+	return w.verify(payload, headers, true)
+}
+
+// VerifyIgnoringTimestamp behaves like Verify, but skips the timestamp
+// tolerance check. This is primarily useful in tests that replay a
+// previously captured payload and headers.
+func (w *Webhook) VerifyIgnoringTimestamp(payload []byte, headers http.Header) error {
+	return w.verify(payload, headers, false)
+}
+
+func (w *Webhook) verify(payload []byte, headers http.Header, checkTimestamp bool) error {
+	msgId := webhookHeader(headers, "svix-id", "webhook-id")
+	msgTimestamp := webhookHeader(headers, "svix-timestamp", "webhook-timestamp")
+	msgSignature := webhookHeader(headers, "svix-signature", "webhook-signature")
+	if msgId == "" || msgTimestamp == "" || msgSignature == "" {
+		return ErrInvalidWebhookSignature
+	}
+
+	if checkTimestamp {
+		if err := w.verifyTimestamp(msgTimestamp); err != nil {
+			return err
+		}
+	}
+
+	expected := w.sign(msgId, msgTimestamp, payload)
+
+	for _, passedSig := range strings.Fields(msgSignature) {
+		version, sig, ok := strings.Cut(passedSig, ",")
+		if !ok || version != "v1" {
+			continue
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(sig)
+		if err != nil {
+			continue
+		}
+
+		if hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+
+	return ErrInvalidWebhookSignature
+}
+
+func (w *Webhook) verifyTimestamp(msgTimestamp string) error {
+	seconds, err := strconv.ParseInt(msgTimestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid webhook timestamp: %w", err)
+	}
+
+	tolerance := w.Tolerance
+	if tolerance <= 0 {
+		tolerance = DefaultWebhookTolerance
+	}
+
+	timestamp := time.Unix(seconds, 0)
+	now := time.Now()
+	if timestamp.Before(now.Add(-tolerance)) || timestamp.After(now.Add(tolerance)) {
+		return ErrWebhookTimestampOutOfTolerance
+	}
+
+	return nil
+}
+
+func (w *Webhook) sign(msgId string, msgTimestamp string, payload []byte) []byte {
+	toSign := msgId + "." + msgTimestamp + "."
+	mac := hmac.New(sha256.New, w.secret)
+	mac.Write([]byte(toSign))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func webhookHeader(headers http.Header, name string, alias string) string {
+	if v := headers.Get(name); v != "" {
+		return v
+	}
+	return headers.Get(alias)
+}
@@ -0,0 +1,155 @@
+func testWebhookSecret() string {
+	return "whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw"
+}
+
+func signTestPayload(t *testing.T, secret string, msgId string, msgTimestamp string, payload []byte) string {
+	t.Helper()
+
+	w, err := NewWebhook(secret)
+	if err != nil {
+		t.Fatalf("NewWebhook: %v", err)
+	}
+
+	return "v1," + base64.StdEncoding.EncodeToString(w.sign(msgId, msgTimestamp, payload))
+}
+
+func TestWebhookVerify(t *testing.T) {
+	secret := testWebhookSecret()
+	payload := []byte(`{"hello": "world"}`)
+	msgId := "msg_p5jXN8AQM9LWM0D4loKWxJek"
+	now := time.Now()
+
+	tests := []struct {
+		name       string
+		headers    func(validSig string) http.Header
+		wantErr    error
+		wantNilErr bool
+	}{
+		{
+			name: "valid svix headers",
+			headers: func(validSig string) http.Header {
+				h := http.Header{}
+				h.Set("svix-id", msgId)
+				h.Set("svix-timestamp", strconv.FormatInt(now.Unix(), 10))
+				h.Set("svix-signature", validSig)
+				return h
+			},
+			wantNilErr: true,
+		},
+		{
+			name: "valid webhook-* aliases",
+			headers: func(validSig string) http.Header {
+				h := http.Header{}
+				h.Set("webhook-id", msgId)
+				h.Set("webhook-timestamp", strconv.FormatInt(now.Unix(), 10))
+				h.Set("webhook-signature", validSig)
+				return h
+			},
+			wantNilErr: true,
+		},
+		{
+			name: "multiple space-separated signatures, one valid",
+			headers: func(validSig string) http.Header {
+				h := http.Header{}
+				h.Set("svix-id", msgId)
+				h.Set("svix-timestamp", strconv.FormatInt(now.Unix(), 10))
+				h.Set("svix-signature", "v1,invalidbase64sig== "+validSig)
+				return h
+			},
+			wantNilErr: true,
+		},
+		{
+			name: "wrong signature",
+			headers: func(validSig string) http.Header {
+				h := http.Header{}
+				h.Set("svix-id", msgId)
+				h.Set("svix-timestamp", strconv.FormatInt(now.Unix(), 10))
+				h.Set("svix-signature", "v1,"+base64.StdEncoding.EncodeToString([]byte("not the right signature")))
+				return h
+			},
+			wantErr: ErrInvalidWebhookSignature,
+		},
+		{
+			name: "missing svix-id",
+			headers: func(validSig string) http.Header {
+				h := http.Header{}
+				h.Set("svix-timestamp", strconv.FormatInt(now.Unix(), 10))
+				h.Set("svix-signature", validSig)
+				return h
+			},
+			wantErr: ErrInvalidWebhookSignature,
+		},
+		{
+			name: "timestamp too old",
+			headers: func(_ string) http.Header {
+				ts := strconv.FormatInt(now.Add(-10*time.Minute).Unix(), 10)
+				h := http.Header{}
+				h.Set("svix-id", msgId)
+				h.Set("svix-timestamp", ts)
+				h.Set("svix-signature", signTestPayload(t, secret, msgId, ts, payload))
+				return h
+			},
+			wantErr: ErrWebhookTimestampOutOfTolerance,
+		},
+		{
+			name: "timestamp too far in the future",
+			headers: func(_ string) http.Header {
+				ts := strconv.FormatInt(now.Add(10*time.Minute).Unix(), 10)
+				h := http.Header{}
+				h.Set("svix-id", msgId)
+				h.Set("svix-timestamp", ts)
+				h.Set("svix-signature", signTestPayload(t, secret, msgId, ts, payload))
+				return h
+			},
+			wantErr: ErrWebhookTimestampOutOfTolerance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validSig := signTestPayload(t, secret, msgId, strconv.FormatInt(now.Unix(), 10), payload)
+			headers := tt.headers(validSig)
+
+			w, err := NewWebhook(secret)
+			if err != nil {
+				t.Fatalf("NewWebhook: %v", err)
+			}
+
+			err = w.Verify(payload, headers)
+			if tt.wantNilErr {
+				if err != nil {
+					t.Fatalf("Verify() = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookVerifyIgnoringTimestamp(t *testing.T) {
+	secret := testWebhookSecret()
+	payload := []byte(`{"hello": "world"}`)
+	msgId := "msg_p5jXN8AQM9LWM0D4loKWxJek"
+	staleTimestamp := strconv.FormatInt(time.Now().Add(-48*time.Hour).Unix(), 10)
+
+	w, err := NewWebhook(secret)
+	if err != nil {
+		t.Fatalf("NewWebhook: %v", err)
+	}
+
+	headers := http.Header{}
+	headers.Set("svix-id", msgId)
+	headers.Set("svix-timestamp", staleTimestamp)
+	headers.Set("svix-signature", signTestPayload(t, secret, msgId, staleTimestamp, payload))
+
+	if err := w.Verify(payload, headers); !errors.Is(err, ErrWebhookTimestampOutOfTolerance) {
+		t.Fatalf("Verify() on a stale timestamp = %v, want %v", err, ErrWebhookTimestampOutOfTolerance)
+	}
+
+	if err := w.VerifyIgnoringTimestamp(payload, headers); err != nil {
+		t.Fatalf("VerifyIgnoringTimestamp() = %v, want nil", err)
+	}
+}